@@ -0,0 +1,191 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"time"
+)
+
+type Bar struct {
+	Timestamp  time.Time `json:"t"`
+	Open       float64   `json:"o"`
+	High       float64   `json:"h"`
+	Low        float64   `json:"l"`
+	Close      float64   `json:"c"`
+	Volume     float64   `json:"v"`
+	TradeCount int64     `json:"n"`
+	VWAP       float64   `json:"vw"`
+}
+
+// ToStockPrice maps an Alpaca bar onto the EOD price format. Alpaca bars are
+// not dividend adjusted, so AdjustedClose is the raw close.
+func (b Bar) ToStockPrice() StockPrice {
+	return StockPrice{
+		Date:          b.Timestamp.Format("2006-01-02"),
+		Open:          b.Open,
+		High:          b.High,
+		Low:           b.Low,
+		Close:         b.Close,
+		AdjustedClose: b.Close,
+		Volume:        b.Volume,
+	}
+}
+
+type AlpacaAccount struct {
+	ID             string  `json:"id"`
+	AccountNumber  string  `json:"account_number"`
+	Status         string  `json:"status"`
+	Currency       string  `json:"currency"`
+	Cash           float64 `json:"cash,string"`
+	BuyingPower    float64 `json:"buying_power,string"`
+	PortfolioValue float64 `json:"portfolio_value,string"`
+	Equity         float64 `json:"equity,string"`
+}
+
+type OrderRequest struct {
+	Symbol      string  `json:"symbol"`
+	Qty         float64 `json:"qty,string"`
+	Side        string  `json:"side"`
+	Type        string  `json:"type"`
+	TimeInForce string  `json:"time_in_force"`
+	LimitPrice  float64 `json:"limit_price,string,omitempty"`
+}
+
+type Order struct {
+	ID             string    `json:"id"`
+	ClientOrderID  string    `json:"client_order_id"`
+	Symbol         string    `json:"symbol"`
+	Qty            float64   `json:"qty,string"`
+	FilledQty      float64   `json:"filled_qty,string"`
+	FilledAvgPrice float64   `json:"filled_avg_price,string"`
+	Side           string    `json:"side"`
+	Type           string    `json:"type"`
+	TimeInForce    string    `json:"time_in_force"`
+	Status         string    `json:"status"`
+	SubmittedAt    time.Time `json:"submitted_at"`
+}
+
+type AlpacaClient struct {
+	apiKey     string
+	apiSecret  string
+	baseURL    string
+	dataURL    string
+	httpClient *http.Client
+}
+
+// NewAlpacaClient returns a client for the Alpaca paper-trading and market
+// data APIs.
+func NewAlpacaClient(apiKey, apiSecret string) *AlpacaClient {
+	return &AlpacaClient{
+		apiKey:    apiKey,
+		apiSecret: apiSecret,
+		baseURL:   "https://paper-api.alpaca.markets",
+		dataURL:   "https://data.alpaca.markets",
+		httpClient: &http.Client{
+			Timeout: 10 * time.Second,
+		},
+	}
+}
+
+func NewAlpacaClientFromEnv() (*AlpacaClient, error) {
+	apiKey := os.Getenv("ALPACA_API_KEY_ID")
+	apiSecret := os.Getenv("ALPACA_API_SECRET_KEY")
+	if apiKey == "" || apiSecret == "" {
+		return nil, fmt.Errorf("ALPACA_API_KEY_ID and ALPACA_API_SECRET_KEY must be set")
+	}
+	return NewAlpacaClient(apiKey, apiSecret), nil
+}
+
+func (c *AlpacaClient) GetBars(symbol, timeframe string, start, end time.Time, limit int) ([]Bar, error) {
+	if symbol == "" {
+		return nil, fmt.Errorf("no symbol provided")
+	}
+	if end.Before(start) {
+		return nil, fmt.Errorf("end must not be before start")
+	}
+
+	params := url.Values{}
+	params.Set("timeframe", timeframe)
+	params.Set("start", start.Format(time.RFC3339))
+	params.Set("end", end.Format(time.RFC3339))
+	if limit > 0 {
+		params.Set("limit", strconv.Itoa(limit))
+	}
+
+	var resp struct {
+		Bars []Bar `json:"bars"`
+	}
+	endpoint := fmt.Sprintf("%s/v2/stocks/%s/bars?%s", c.dataURL, symbol, params.Encode())
+	if err := c.do(http.MethodGet, endpoint, nil, &resp); err != nil {
+		return nil, err
+	}
+
+	return resp.Bars, nil
+}
+
+func (c *AlpacaClient) GetAccount() (AlpacaAccount, error) {
+	var account AlpacaAccount
+	if err := c.do(http.MethodGet, c.baseURL+"/v2/account", nil, &account); err != nil {
+		return AlpacaAccount{}, err
+	}
+	return account, nil
+}
+
+func (c *AlpacaClient) PlaceOrder(order OrderRequest) (Order, error) {
+	if order.Symbol == "" {
+		return Order{}, fmt.Errorf("no symbol provided")
+	}
+	if order.Qty <= 0 {
+		return Order{}, fmt.Errorf("qty must be positive")
+	}
+
+	body, err := json.Marshal(order)
+	if err != nil {
+		return Order{}, fmt.Errorf("error encoding order: %v", err)
+	}
+
+	var placed Order
+	if err := c.do(http.MethodPost, c.baseURL+"/v2/orders", body, &placed); err != nil {
+		return Order{}, err
+	}
+	return placed, nil
+}
+
+func (c *AlpacaClient) do(method, endpoint string, payload []byte, v interface{}) error {
+	req, err := http.NewRequest(method, endpoint, bytes.NewReader(payload))
+	if err != nil {
+		return fmt.Errorf("error creating request: %v", err)
+	}
+	req.Header.Set("APCA-API-KEY-ID", c.apiKey)
+	req.Header.Set("APCA-API-SECRET-KEY", c.apiSecret)
+	if payload != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("error making request: %v", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("error reading response: %v", err)
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(body))
+	}
+
+	if err := json.Unmarshal(body, v); err != nil {
+		return fmt.Errorf("error parsing JSON: %v", err)
+	}
+
+	return nil
+}
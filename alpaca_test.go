@@ -0,0 +1,107 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+)
+
+func newTestAlpacaClient(t *testing.T, handler http.HandlerFunc) *AlpacaClient {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("APCA-API-KEY-ID") != "key" || r.Header.Get("APCA-API-SECRET-KEY") != "secret" {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		handler(w, r)
+	}))
+	t.Cleanup(server.Close)
+
+	client := NewAlpacaClient("key", "secret")
+	client.baseURL = server.URL
+	client.dataURL = server.URL
+	return client
+}
+
+func TestAlpacaGetBars(t *testing.T) {
+	client := newTestAlpacaClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v2/stocks/AAPL/bars" || r.URL.Query().Get("timeframe") != "1Day" {
+			http.Error(w, "bad request", http.StatusBadRequest)
+			return
+		}
+		w.Write([]byte(`{"bars":[{"t":"2024-01-02T05:00:00Z","o":187.15,"h":188.44,"l":183.89,"c":185.64,"v":82488700,"n":1009074,"vw":185.9}],"symbol":"AAPL"}`))
+	})
+
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	bars, err := client.GetBars("AAPL", "1Day", start, start.AddDate(0, 0, 7), 10)
+	if err != nil {
+		t.Fatalf("GetBars() error = %v", err)
+	}
+	if len(bars) != 1 {
+		t.Fatalf("GetBars() returned %d bars, want 1", len(bars))
+	}
+
+	price := bars[0].ToStockPrice()
+	want := StockPrice{Date: "2024-01-02", Open: 187.15, High: 188.44, Low: 183.89, Close: 185.64, AdjustedClose: 185.64, Volume: 82488700}
+	if price != want {
+		t.Errorf("ToStockPrice() = %+v, want %+v", price, want)
+	}
+}
+
+func TestAlpacaGetAccount(t *testing.T) {
+	client := newTestAlpacaClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"id":"abc","account_number":"PA123","status":"ACTIVE","currency":"USD","cash":"1000.50","buying_power":"2001","portfolio_value":"1000.50","equity":"1000.50"}`))
+	})
+
+	account, err := client.GetAccount()
+	if err != nil {
+		t.Fatalf("GetAccount() error = %v", err)
+	}
+	if account.Cash != 1000.50 || account.BuyingPower != 2001 || account.Status != "ACTIVE" {
+		t.Errorf("GetAccount() = %+v", account)
+	}
+}
+
+func TestAlpacaPlaceOrder(t *testing.T) {
+	client := newTestAlpacaClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/v2/orders" {
+			http.Error(w, "bad request", http.StatusBadRequest)
+			return
+		}
+		var req map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req["qty"] != "5" {
+			http.Error(w, "bad order", http.StatusUnprocessableEntity)
+			return
+		}
+		w.Write([]byte(`{"id":"order-1","symbol":"SPY","qty":"5","filled_qty":"0","filled_avg_price":null,"side":"buy","type":"market","time_in_force":"day","status":"accepted","submitted_at":"2024-01-02T15:00:00Z"}`))
+	})
+
+	order, err := client.PlaceOrder(OrderRequest{Symbol: "SPY", Qty: 5, Side: "buy", Type: "market", TimeInForce: "day"})
+	if err != nil {
+		t.Fatalf("PlaceOrder() error = %v", err)
+	}
+	if order.ID != "order-1" || order.Qty != 5 || order.Status != "accepted" {
+		t.Errorf("PlaceOrder() = %+v", order)
+	}
+
+	if _, err := client.PlaceOrder(OrderRequest{Symbol: "SPY"}); err == nil {
+		t.Error("PlaceOrder() with zero qty should fail")
+	}
+}
+
+func TestAlpacaIntegration(t *testing.T) {
+	if os.Getenv("ALPACA_API_KEY_ID") == "" || os.Getenv("ALPACA_API_SECRET_KEY") == "" {
+		t.SkipNow()
+	}
+
+	client, err := NewAlpacaClientFromEnv()
+	if err != nil {
+		t.Fatalf("NewAlpacaClientFromEnv() error = %v", err)
+	}
+	if _, err := client.GetAccount(); err != nil {
+		t.Errorf("GetAccount() error = %v", err)
+	}
+}
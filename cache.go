@@ -0,0 +1,80 @@
+package main
+
+import (
+	"sync"
+	"time"
+)
+
+// Cache stores fetched price series keyed by "symbol:startDate:endDate".
+type Cache interface {
+	Get(key string) ([]StockPrice, bool)
+	Set(key string, prices []StockPrice)
+}
+
+func cacheKey(symbol, startDate, endDate string) string {
+	return symbol + ":" + startDate + ":" + endDate
+}
+
+// MemoryCache keeps entries for the lifetime of the process.
+type MemoryCache struct {
+	entries sync.Map
+}
+
+func NewMemoryCache() *MemoryCache {
+	return &MemoryCache{}
+}
+
+func (c *MemoryCache) Get(key string) ([]StockPrice, bool) {
+	v, ok := c.entries.Load(key)
+	if !ok {
+		return nil, false
+	}
+	return v.([]StockPrice), true
+}
+
+func (c *MemoryCache) Set(key string, prices []StockPrice) {
+	c.entries.Store(key, prices)
+}
+
+type ttlEntry struct {
+	prices  []StockPrice
+	expires time.Time
+}
+
+// TTLCache expires entries a fixed duration after they are set.
+type TTLCache struct {
+	mu      sync.Mutex
+	ttl     time.Duration
+	entries map[string]ttlEntry
+	now     func() time.Time
+}
+
+func NewTTLCache(ttl time.Duration) *TTLCache {
+	return &TTLCache{
+		ttl:     ttl,
+		entries: make(map[string]ttlEntry),
+		now:     time.Now,
+	}
+}
+
+func (c *TTLCache) Get(key string) ([]StockPrice, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	if !c.now().Before(entry.expires) {
+		delete(c.entries, key)
+		return nil, false
+	}
+	return entry.prices, true
+}
+
+func (c *TTLCache) Set(key string, prices []StockPrice) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries[key] = ttlEntry{prices: prices, expires: c.now().Add(c.ttl)}
+}
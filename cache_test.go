@@ -0,0 +1,60 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestTTLCacheExpiry(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	cache := NewTTLCache(time.Minute)
+	cache.now = func() time.Time { return now }
+
+	cache.Set("SPY:2024-01-01:2024-01-31", []StockPrice{{Date: "2024-01-02"}})
+
+	now = now.Add(59 * time.Second)
+	if _, ok := cache.Get("SPY:2024-01-01:2024-01-31"); !ok {
+		t.Error("Get() missed an entry before its TTL")
+	}
+
+	now = now.Add(time.Second)
+	if _, ok := cache.Get("SPY:2024-01-01:2024-01-31"); ok {
+		t.Error("Get() returned an entry after its TTL")
+	}
+}
+
+func TestGetPricesUsesCache(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.Write([]byte(`[{"date":"2024-01-02","open":1,"high":2,"low":0.5,"close":1.5}]`))
+	}))
+	defer server.Close()
+
+	client := NewEODClientWithCache("test-key", NewMemoryCache())
+	client.baseURL = server.URL
+
+	for i := 0; i < 2; i++ {
+		results, err := client.GetPrices([]string{"SPY"}, "2024-01-01", "2024-01-31")
+		if err != nil {
+			t.Fatalf("GetPrices() error = %v", err)
+		}
+		if len(results["SPY"]) != 1 {
+			t.Fatalf("results[SPY] = %v, want one price", results["SPY"])
+		}
+	}
+	if got := atomic.LoadInt32(&requests); got != 1 {
+		t.Errorf("made %d HTTP requests, want 1", got)
+	}
+
+	// A different date range is a different cache key
+	if _, err := client.GetPrices([]string{"SPY"}, "2024-02-01", "2024-02-29"); err != nil {
+		t.Fatalf("GetPrices() error = %v", err)
+	}
+	if got := atomic.LoadInt32(&requests); got != 2 {
+		t.Errorf("made %d HTTP requests, want 2", got)
+	}
+}
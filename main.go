@@ -2,7 +2,9 @@ package main
 
 import (
 	"github.com/spf13/cobra"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"log"
@@ -15,6 +17,7 @@ import (
 	"github.com/pocketbase/pocketbase"
 	"github.com/pocketbase/pocketbase/apis"
 	"github.com/pocketbase/pocketbase/core"
+	"golang.org/x/time/rate"
 )
 
 type StockPrice struct {
@@ -27,27 +30,163 @@ type StockPrice struct {
 	Volume        float64 `json:"volume"`
 }
 
+// MarketDataProvider is implemented by every price client, so callers can
+// swap data sources without changing how prices are consumed.
+type MarketDataProvider interface {
+	GetPrices(symbols []string, startDate, endDate string) (map[string][]StockPrice, error)
+}
+
+var (
+	_ MarketDataProvider = (*EODClient)(nil)
+	_ MarketDataProvider = (*YahooClient)(nil)
+	_ MarketDataProvider = (*PolygonClient)(nil)
+	_ MarketDataProvider = (*TiingoClient)(nil)
+)
+
+// BulkPrice is one row of the EODHD bulk end-of-day feed, which returns every
+// ticker on an exchange for a single day.
+type BulkPrice struct {
+	Code          string  `json:"code"`
+	Exchange      string  `json:"exchange_short_name"`
+	Date          string  `json:"date"`
+	Open          float64 `json:"open"`
+	High          float64 `json:"high"`
+	Low           float64 `json:"low"`
+	Close         float64 `json:"close"`
+	AdjustedClose float64 `json:"adjusted_close"`
+	Volume        float64 `json:"volume"`
+}
+
+func (b BulkPrice) ToStockPrice() StockPrice {
+	return StockPrice{
+		Date:          b.Date,
+		Open:          b.Open,
+		High:          b.High,
+		Low:           b.Low,
+		Close:         b.Close,
+		AdjustedClose: b.AdjustedClose,
+		Volume:        b.Volume,
+	}
+}
+
+type Fundamentals struct {
+	PERatio       float64 `json:"pe_ratio"`
+	EPS           float64 `json:"eps"`
+	MarketCap     float64 `json:"market_cap"`
+	Sector        string  `json:"sector"`
+	Industry      string  `json:"industry"`
+	DividendYield float64 `json:"dividend_yield"`
+	Beta          float64 `json:"beta"`
+}
+
+// fundamentalsResponse mirrors the subset of the EODHD fundamentals payload we use.
+type fundamentalsResponse struct {
+	General struct {
+		Sector   string `json:"Sector"`
+		Industry string `json:"Industry"`
+	} `json:"General"`
+	Highlights struct {
+		MarketCapitalization float64 `json:"MarketCapitalization"`
+		PERatio              float64 `json:"PERatio"`
+		EarningsShare        float64 `json:"EarningsShare"`
+		DividendYield        float64 `json:"DividendYield"`
+	} `json:"Highlights"`
+	Technicals struct {
+		Beta float64 `json:"Beta"`
+	} `json:"Technicals"`
+}
+
+const (
+	// defaultRateLimit matches the EODHD free tier, in requests per second.
+	defaultRateLimit = 5
+
+	defaultMaxRetries   = 3
+	defaultRetryBackoff = 500 * time.Millisecond
+	maxRetryBackoff     = 60 * time.Second
+)
+
 type EODClient struct {
+	// MaxRetries is how many times a request failing with 429, 5xx or a
+	// network error is retried, waiting RetryBackoff * 2^attempt in between.
+	MaxRetries   int
+	RetryBackoff time.Duration
+
+	// Strict makes GetPrices fail on any row rejected by ValidatePrices.
+	// Otherwise bad rows are logged and dropped.
+	Strict bool
+
 	apiKey     string
+	baseURL    string
 	httpClient *http.Client
+	limiter    *rate.Limiter
+	cache      Cache
+}
+
+// MultiError is returned by GetPrices when one or more symbols fail to fetch.
+// PartialResults holds the prices for the symbols that succeeded.
+type MultiError struct {
+	Errors         []error
+	PartialResults map[string][]StockPrice
+}
+
+func (e *MultiError) Error() string {
+	msgs := make([]string, len(e.Errors))
+	for i, err := range e.Errors {
+		msgs[i] = err.Error()
+	}
+	return fmt.Sprintf("%d errors occurred: %s", len(e.Errors), strings.Join(msgs, "; "))
 }
 
 func NewEODClient(apiKey string) *EODClient {
+	return NewEODClientWithRateLimit(apiKey, defaultRateLimit)
+}
+
+// NewEODClientWithRateLimit returns a client that issues at most rps API
+// requests per second, shared across all concurrent fetches.
+func NewEODClientWithRateLimit(apiKey string, rps float64) *EODClient {
 	return &EODClient{
-		apiKey: apiKey,
+		apiKey:  apiKey,
+		baseURL: "https://eodhd.com/api",
 		httpClient: &http.Client{
 			Timeout: 10 * time.Second,
 		},
+		limiter:      rate.NewLimiter(rate.Limit(rps), 1),
+		MaxRetries:   defaultMaxRetries,
+		RetryBackoff: defaultRetryBackoff,
 	}
 }
 
+// NewEODClientWithCache returns a client that serves repeated price requests
+// for the same symbol and date range from cache.
+func NewEODClientWithCache(apiKey string, cache Cache) *EODClient {
+	client := NewEODClient(apiKey)
+	client.cache = cache
+	return client
+}
+
 func (c *EODClient) GetPrices(symbols []string, startDate, endDate string) (map[string][]StockPrice, error) {
+	return c.GetPricesWithProgress(symbols, startDate, endDate, nil)
+}
+
+// GetPricesWithProgress behaves like GetPrices and also sends the number of
+// completed fetches on progress as each symbol finishes. Sends never block, so
+// a slow reader may miss updates; pass nil to disable progress reporting.
+func (c *EODClient) GetPricesWithProgress(symbols []string, startDate, endDate string, progress chan<- int) (map[string][]StockPrice, error) {
 	if err := c.validateInput(symbols, startDate, endDate); err != nil {
 		return nil, err
 	}
 
+	return fetchConcurrently(symbols, progress, func(symbol string) ([]StockPrice, error) {
+		return c.fetchEOD(symbol, startDate, endDate)
+	})
+}
+
+// fetchConcurrently runs fetch for every symbol in parallel. If any symbol
+// fails, the successful results are returned together with a *MultiError.
+// A non-nil progress channel receives the running count of finished fetches.
+func fetchConcurrently(symbols []string, progress chan<- int, fetch func(symbol string) ([]StockPrice, error)) (map[string][]StockPrice, error) {
 	results := make(map[string][]StockPrice)
-	errorChan := make(chan error, len(symbols))
+	symbolErrors := make(map[string]error)
 	resultChan := make(chan struct {
 		symbol string
 		prices []StockPrice
@@ -57,7 +196,7 @@ func (c *EODClient) GetPrices(symbols []string, startDate, endDate string) (map[
 	// Fetch prices concurrently
 	for _, symbol := range symbols {
 		go func(sym string) {
-			prices, err := c.fetchEOD(sym, startDate, endDate)
+			prices, err := fetch(sym)
 			resultChan <- struct {
 				symbol string
 				prices []StockPrice
@@ -67,22 +206,33 @@ func (c *EODClient) GetPrices(symbols []string, startDate, endDate string) (map[
 	}
 
 	// Collect results
-	for range symbols {
+	for completed := 1; completed <= len(symbols); completed++ {
 		result := <-resultChan
+		if progress != nil {
+			select {
+			case progress <- completed:
+			default:
+			}
+		}
 		if result.err != nil {
-			errorChan <- fmt.Errorf("error fetching data for %s: %v", result.symbol, result.err)
+			symbolErrors[result.symbol] = fmt.Errorf("error fetching data for %s: %v", result.symbol, result.err)
 			continue
 		}
 		results[result.symbol] = result.prices
 	}
 
-	// Check for any errors
-	select {
-	case err := <-errorChan:
-		return nil, err
-	default:
-		return results, nil
+	// Report every failure in input order, alongside what did succeed
+	if len(symbolErrors) > 0 {
+		multiErr := &MultiError{PartialResults: results}
+		for _, symbol := range symbols {
+			if err, ok := symbolErrors[symbol]; ok {
+				multiErr.Errors = append(multiErr.Errors, err)
+			}
+		}
+		return results, multiErr
 	}
+
+	return results, nil
 }
 
 func (c *EODClient) validateInput(symbols []string, startDate, endDate string) error {
@@ -105,44 +255,198 @@ func (c *EODClient) validateInput(symbols []string, startDate, endDate string) e
 }
 
 func (c *EODClient) fetchEOD(symbol, startDate, endDate string) ([]StockPrice, error) {
-	url := fmt.Sprintf("https://eodhd.com/api/eod/%s?from=%s&to=%s&api_token=%s&fmt=json",
-		symbol, startDate, endDate, c.apiKey)
+	key := cacheKey(symbol, startDate, endDate)
+	if c.cache != nil {
+		if prices, ok := c.cache.Get(key); ok {
+			return prices, nil
+		}
+	}
+
+	url := fmt.Sprintf("%s/eod/%s?from=%s&to=%s&api_token=%s&fmt=json",
+		c.baseURL, symbol, startDate, endDate, c.apiKey)
+
+	var prices []StockPrice
+	if err := c.fetchAndUnmarshal(url, &prices); err != nil {
+		return nil, err
+	}
+
+	if c.Strict {
+		if err := ValidatePrices(prices); err != nil {
+			return nil, fmt.Errorf("invalid price data: %v", err)
+		}
+	} else {
+		prices = dropInvalidPrices(symbol, prices)
+	}
+
+	if c.cache != nil {
+		c.cache.Set(key, prices)
+	}
+
+	return prices, nil
+}
+
+// GetBulkEOD fetches end-of-day prices for every ticker on an exchange for one
+// date in a single request.
+func (c *EODClient) GetBulkEOD(exchange, date string) ([]BulkPrice, error) {
+	if exchange == "" {
+		return nil, fmt.Errorf("no exchange provided")
+	}
+	if c.apiKey == "" {
+		return nil, fmt.Errorf("API key is missing")
+	}
+	if err := validateDate(date); err != nil {
+		return nil, fmt.Errorf("invalid date: %v", err)
+	}
+
+	url := fmt.Sprintf("%s/eod-bulk-last-day/%s?date=%s&api_token=%s&fmt=json",
+		c.baseURL, exchange, date, c.apiKey)
+
+	var prices []BulkPrice
+	if err := c.fetchAndUnmarshal(url, &prices); err != nil {
+		return nil, err
+	}
+
+	return prices, nil
+}
+
+// GetFundamentals fetches valuation and classification data for a symbol.
+// Fields missing from the API response are left at their zero value.
+func (c *EODClient) GetFundamentals(symbol string) (Fundamentals, error) {
+	if symbol == "" {
+		return Fundamentals{}, fmt.Errorf("no symbol provided")
+	}
+	if c.apiKey == "" {
+		return Fundamentals{}, fmt.Errorf("API key is missing")
+	}
+
+	url := fmt.Sprintf("%s/fundamentals/%s?api_token=%s&fmt=json",
+		c.baseURL, symbol, c.apiKey)
+
+	var resp fundamentalsResponse
+	if err := c.fetchAndUnmarshal(url, &resp); err != nil {
+		return Fundamentals{}, err
+	}
+
+	return Fundamentals{
+		PERatio:       resp.Highlights.PERatio,
+		EPS:           resp.Highlights.EarningsShare,
+		MarketCap:     resp.Highlights.MarketCapitalization,
+		Sector:        resp.General.Sector,
+		Industry:      resp.General.Industry,
+		DividendYield: resp.Highlights.DividendYield,
+		Beta:          resp.Technicals.Beta,
+	}, nil
+}
+
+// GetBulkFundamentals fetches fundamentals for several symbols concurrently.
+// Symbols that fail are omitted from the result and reported in the error.
+func (c *EODClient) GetBulkFundamentals(symbols []string) (map[string]Fundamentals, error) {
+	if len(symbols) == 0 {
+		return nil, fmt.Errorf("no symbols provided")
+	}
 
-	resp, err := c.httpClient.Get(url)
+	results := make(map[string]Fundamentals)
+	resultChan := make(chan struct {
+		symbol       string
+		fundamentals Fundamentals
+		err          error
+	}, len(symbols))
+
+	for _, symbol := range symbols {
+		go func(sym string) {
+			fundamentals, err := c.GetFundamentals(sym)
+			resultChan <- struct {
+				symbol       string
+				fundamentals Fundamentals
+				err          error
+			}{sym, fundamentals, err}
+		}(symbol)
+	}
+
+	var errs []error
+	for range symbols {
+		result := <-resultChan
+		if result.err != nil {
+			errs = append(errs, fmt.Errorf("error fetching fundamentals for %s: %v", result.symbol, result.err))
+			continue
+		}
+		results[result.symbol] = result.fundamentals
+	}
+
+	return results, errors.Join(errs...)
+}
+
+func (c *EODClient) fetchAndUnmarshal(url string, v interface{}) error {
+	// Bound the whole call, including retries, by the client timeout
+	ctx := context.Background()
+	if c.httpClient.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.httpClient.Timeout)
+		defer cancel()
+	}
+
+	for attempt := 0; ; attempt++ {
+		if err := c.limiter.Wait(ctx); err != nil {
+			return fmt.Errorf("rate limiter: %v", err)
+		}
+
+		body, retryable, err := c.get(ctx, url)
+		if err == nil {
+			if err := json.Unmarshal(body, v); err != nil {
+				return fmt.Errorf("error parsing JSON: %v", err)
+			}
+			return nil
+		}
+		if !retryable || attempt >= c.MaxRetries {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(retryBackoff(c.RetryBackoff, attempt)):
+		}
+	}
+}
+
+// get performs a single request. retryable reports whether the failure is
+// transient: a network error, 429 or 5xx.
+func (c *EODClient) get(ctx context.Context, url string) (body []byte, retryable bool, err error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, false, fmt.Errorf("error creating request: %v", err)
+	}
+
+	resp, err := c.httpClient.Do(req)
 	if err != nil {
-		return nil, fmt.Errorf("error making request: %v", err)
+		return nil, ctx.Err() == nil, fmt.Errorf("error making request: %v", err)
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
 		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(body))
+		retryable := resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500
+		return nil, retryable, fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(body))
 	}
 
-	body, err := io.ReadAll(resp.Body)
+	body, err = io.ReadAll(resp.Body)
 	if err != nil {
-		return nil, fmt.Errorf("error reading response: %v", err)
+		return nil, false, fmt.Errorf("error reading response: %v", err)
 	}
 
-	var prices []StockPrice
-	if err := json.Unmarshal(body, &prices); err != nil {
-		return nil, fmt.Errorf("error parsing JSON: %v", err)
-	}
-
-	return prices, nil
+	return body, false, nil
 }
 
-func formatPriceData(symbol string, prices []StockPrice) {
-	fmt.Printf("\nPrice data for %s:\n", symbol)
-	fmt.Printf("%-12s %-10s %-10s %-10s %-10s %-10s\n",
-		"Date", "Open", "High", "Low", "Close", "AdjustedClose")
-	fmt.Println(strings.Repeat("-", 60))
-
-	for _, price := range prices {
-		fmt.Printf("%-12s $%-9.2f $%-9.2f $%-9.2f $%-9.2f $%-9.2f\n",
-			price.Date, price.Open, price.High, price.Low,
-			price.Close, price.AdjustedClose)
+// retryBackoff doubles base for each attempt, capped at maxRetryBackoff.
+func retryBackoff(base time.Duration, attempt int) time.Duration {
+	wait := base
+	for i := 0; i < attempt && wait < maxRetryBackoff; i++ {
+		wait *= 2
+	}
+	if wait > maxRetryBackoff {
+		return maxRetryBackoff
 	}
+	return wait
 }
 
 func validateDate(date string) error {
@@ -154,7 +458,16 @@ func validateDate(date string) error {
 }
 
 func runBacktester(cmd *cobra.Command, args []string) {
-	fmt.Println("Running backtester")
+	showProgress, _ := cmd.Flags().GetBool("progress")
+	output, _ := cmd.Flags().GetString("output")
+	if err := validateOutputFormat(output); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	if output == outputTable {
+		fmt.Println("Running backtester")
+	}
+
 	apiKey := os.Getenv("EODHD_API_KEY")
 	if apiKey == "" {
 		fmt.Println("Please set EODHD_API_KEY environment variable")
@@ -166,26 +479,54 @@ func runBacktester(cmd *cobra.Command, args []string) {
 	startDate := "2024-01-01"
 	endDate := "2024-12-31"
 
-	results, err := client.GetPrices(symbols, startDate, endDate)
+	var progress chan int
+	done := make(chan struct{})
+	if showProgress {
+		progress = make(chan int, len(symbols))
+		go func() {
+			defer close(done)
+			for n := range progress {
+				fmt.Fprintf(os.Stderr, "\rFetched %d/%d symbols", n, len(symbols))
+			}
+			fmt.Fprintln(os.Stderr)
+		}()
+	}
+
+	results, err := client.GetPricesWithProgress(symbols, startDate, endDate, progress)
+	if progress != nil {
+		close(progress)
+		<-done
+	}
 	if err != nil {
-		fmt.Printf("Error fetching prices: %v\n", err)
-		return
+		var multiErr *MultiError
+		if !errors.As(err, &multiErr) {
+			fmt.Fprintf(os.Stderr, "Error fetching prices: %v\n", err)
+			return
+		}
+		// Some symbols failed; report them and show the rest
+		for _, e := range multiErr.Errors {
+			fmt.Fprintf(os.Stderr, "Error fetching prices: %v\n", e)
+		}
+		results = multiErr.PartialResults
 	}
 
-	for symbol, prices := range results {
-		formatPriceData(symbol, prices)
+	if err := writePrices(os.Stdout, output, results); err != nil {
+		fmt.Fprintf(os.Stderr, "Error writing output: %v\n", err)
 	}
 }
 
 func main() {
 	app := pocketbase.New()
-	app.RootCmd.AddCommand(&cobra.Command{
-			Use:   "backtester",
-			Short: "Run backtester",
-			Run: func(cmd *cobra.Command, args []string) {
-				runBacktester(cmd, args)
-			},
-		})
+	backtesterCmd := &cobra.Command{
+		Use:   "backtester",
+		Short: "Run backtester",
+		Run: func(cmd *cobra.Command, args []string) {
+			runBacktester(cmd, args)
+		},
+	}
+	backtesterCmd.Flags().Bool("progress", false, "Print a live fetch counter to stderr")
+	backtesterCmd.Flags().String("output", outputTable, "Output format: table, json or csv")
+	app.RootCmd.AddCommand(backtesterCmd)
 
 	app.OnServe().BindFunc(func(se *core.ServeEvent) error {
 		// serves static files from the provided public dir (if exists)
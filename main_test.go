@@ -1,7 +1,14 @@
 package main
 
 import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 )
 
 func TestValidateDate(t *testing.T) {
@@ -25,3 +32,296 @@ func TestValidateDate(t *testing.T) {
 		})
 	}
 }
+
+func TestGetPricesPartialFailure(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/eod/SPY" {
+			http.Error(w, "unknown symbol", http.StatusNotFound)
+			return
+		}
+		w.Write([]byte(`[{"date":"2024-01-02","open":1,"high":2,"low":0.5,"close":1.5,"adjusted_close":1.5,"volume":100}]`))
+	}))
+	defer server.Close()
+
+	client := NewEODClient("test-key")
+	client.baseURL = server.URL
+
+	results, err := client.GetPrices([]string{"SPY", "BAD1", "BAD2"}, "2024-01-01", "2024-01-31")
+
+	var multiErr *MultiError
+	if !errors.As(err, &multiErr) {
+		t.Fatalf("GetPrices() error = %v, want *MultiError", err)
+	}
+	if len(multiErr.Errors) != 2 {
+		t.Errorf("len(Errors) = %d, want 2", len(multiErr.Errors))
+	}
+	if len(multiErr.PartialResults) != 1 || len(results) != 1 {
+		t.Fatalf("got %d partial results, want 1", len(multiErr.PartialResults))
+	}
+	if prices := results["SPY"]; len(prices) != 1 || prices[0].Close != 1.5 {
+		t.Errorf("results[SPY] = %v, want one price with close 1.5", prices)
+	}
+	for _, sym := range []string{"BAD1", "BAD2"} {
+		if !strings.Contains(multiErr.Error(), sym) {
+			t.Errorf("Error() = %q, want mention of %s", multiErr.Error(), sym)
+		}
+	}
+}
+
+func TestGetFundamentals(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/fundamentals/AAPL":
+			w.Write([]byte(`{
+				"General": {"Sector": "Technology", "Industry": "Consumer Electronics"},
+				"Highlights": {"MarketCapitalization": 3000000000000, "PERatio": 30.5, "EarningsShare": 6.1, "DividendYield": 0.005},
+				"Technicals": {"Beta": 1.2}
+			}`))
+		case "/fundamentals/SPARSE":
+			w.Write([]byte(`{"General": {"Sector": "Energy"}}`))
+		default:
+			http.Error(w, "unknown symbol", http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := NewEODClient("test-key")
+	client.baseURL = server.URL
+
+	got, err := client.GetFundamentals("AAPL")
+	if err != nil {
+		t.Fatalf("GetFundamentals() error = %v", err)
+	}
+	want := Fundamentals{
+		PERatio:       30.5,
+		EPS:           6.1,
+		MarketCap:     3000000000000,
+		Sector:        "Technology",
+		Industry:      "Consumer Electronics",
+		DividendYield: 0.005,
+		Beta:          1.2,
+	}
+	if got != want {
+		t.Errorf("GetFundamentals() = %+v, want %+v", got, want)
+	}
+
+	sparse, err := client.GetFundamentals("SPARSE")
+	if err != nil {
+		t.Fatalf("GetFundamentals() error = %v", err)
+	}
+	if sparse != (Fundamentals{Sector: "Energy"}) {
+		t.Errorf("GetFundamentals() = %+v, want only Sector set", sparse)
+	}
+
+	bulk, err := client.GetBulkFundamentals([]string{"AAPL", "SPARSE", "MISSING"})
+	if err == nil || !strings.Contains(err.Error(), "MISSING") {
+		t.Errorf("GetBulkFundamentals() error = %v, want mention of MISSING", err)
+	}
+	if len(bulk) != 2 {
+		t.Errorf("GetBulkFundamentals() returned %d results, want 2", len(bulk))
+	}
+}
+
+// FuzzValidateDate runs its seed corpus with go test. To fuzz for a fixed duration:
+//
+//	go test -run=^$ -fuzz=FuzzValidateDate -fuzztime=30s
+func FuzzValidateDate(f *testing.F) {
+	for _, seed := range []string{"2024-01-01", "2024-02-29", "2023-02-29", "01-01-2024", "2024-13-01", "", "2024-1-1", " 2024-01-01"} {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, date string) {
+		err := validateDate(date)
+		if (err == nil) != (validateDate(date) == nil) {
+			t.Fatalf("validateDate(%q) is not deterministic", date)
+		}
+		if err != nil {
+			return
+		}
+		// Anything accepted must be a canonical YYYY-MM-DD date
+		parsed, parseErr := time.Parse("2006-01-02", date)
+		if parseErr != nil || parsed.Format("2006-01-02") != date {
+			t.Errorf("validateDate(%q) accepted a non-canonical date", date)
+		}
+	})
+}
+
+func TestGetBulkEOD(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/eod-bulk-last-day/US" || r.URL.Query().Get("date") != "2024-01-02" {
+			http.Error(w, "bad request", http.StatusBadRequest)
+			return
+		}
+		w.Write([]byte(`[
+			{"code":"AAPL","exchange_short_name":"US","date":"2024-01-02","open":187.15,"high":188.44,"low":183.89,"close":185.64,"adjusted_close":184.94,"volume":82488700},
+			{"code":"MSFT","exchange_short_name":"US","date":"2024-01-02","open":373.86,"high":375.9,"low":366.77,"close":370.87,"adjusted_close":368.02,"volume":25258600},
+			{"code":"SPY","exchange_short_name":"US","date":"2024-01-02","open":472.16,"high":473.67,"low":470.49,"close":472.65,"adjusted_close":466.61,"volume":123623700},
+			{"code":"VTI","exchange_short_name":"US","date":"2024-01-02","open":236.5,"high":237.2,"low":235.1,"close":236.6,"adjusted_close":233.9,"volume":3800000},
+			{"code":"BND","exchange_short_name":"US","date":"2024-01-02","open":73.5,"high":73.6,"low":73.2,"close":73.3,"adjusted_close":71.2,"volume":6100000}
+		]`))
+	}))
+	defer server.Close()
+
+	client := NewEODClient("test-key")
+	client.baseURL = server.URL
+
+	prices, err := client.GetBulkEOD("US", "2024-01-02")
+	if err != nil {
+		t.Fatalf("GetBulkEOD() error = %v", err)
+	}
+	if len(prices) != 5 {
+		t.Fatalf("GetBulkEOD() returned %d rows, want 5", len(prices))
+	}
+
+	codes := make(map[string]StockPrice)
+	for _, p := range prices {
+		if p.Exchange != "US" || p.Date != "2024-01-02" {
+			t.Errorf("unexpected row %+v", p)
+		}
+		codes[p.Code] = p.ToStockPrice()
+	}
+	if got := codes["SPY"]; got.Close != 472.65 || got.AdjustedClose != 466.61 || got.Volume != 123623700 {
+		t.Errorf("SPY price = %+v", got)
+	}
+
+	if _, err := client.GetBulkEOD("US", "01-02-2024"); err == nil {
+		t.Error("GetBulkEOD() with invalid date should fail")
+	}
+}
+
+type recordingTransport struct {
+	mu    sync.Mutex
+	times []time.Time
+}
+
+func (rt *recordingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	rt.mu.Lock()
+	rt.times = append(rt.times, time.Now())
+	rt.mu.Unlock()
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Body:       io.NopCloser(strings.NewReader(`[]`)),
+		Header:     make(http.Header),
+	}, nil
+}
+
+func TestGetPricesRateLimit(t *testing.T) {
+	transport := &recordingTransport{}
+	client := NewEODClientWithRateLimit("test-key", 10)
+	client.httpClient.Transport = transport
+
+	symbols := []string{"A", "B", "C", "D", "E"}
+	start := time.Now()
+	if _, err := client.GetPrices(symbols, "2024-01-01", "2024-01-31"); err != nil {
+		t.Fatalf("GetPrices() error = %v", err)
+	}
+
+	if len(transport.times) != len(symbols) {
+		t.Fatalf("got %d requests, want %d", len(transport.times), len(symbols))
+	}
+	// At 10 rps with a burst of 1, at most 3 requests fit in the first 250ms
+	inWindow := 0
+	for _, ts := range transport.times {
+		if ts.Sub(start) < 250*time.Millisecond {
+			inWindow++
+		}
+	}
+	if inWindow > 3 {
+		t.Errorf("%d requests fired within 250ms, want at most 3", inWindow)
+	}
+}
+
+type sequenceTransport struct {
+	mu       sync.Mutex
+	statuses []int
+	calls    int
+}
+
+func (st *sequenceTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	status := st.statuses[len(st.statuses)-1]
+	if st.calls < len(st.statuses) {
+		status = st.statuses[st.calls]
+	}
+	st.calls++
+	return &http.Response{
+		StatusCode: status,
+		Body:       io.NopCloser(strings.NewReader(`[{"date":"2024-01-02","open":1,"high":2,"low":0.5,"close":1.5}]`)),
+		Header:     make(http.Header),
+	}, nil
+}
+
+func TestFetchRetries(t *testing.T) {
+	tests := []struct {
+		name      string
+		statuses  []int
+		wantCalls int
+		wantErr   bool
+	}{
+		{"429 twice then success", []int{429, 429, 200}, 3, false},
+		{"503 then success", []int{503, 200}, 2, false},
+		{"404 is not retried", []int{404, 200}, 1, true},
+		{"gives up after MaxRetries", []int{500}, 4, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			transport := &sequenceTransport{statuses: tt.statuses}
+			client := NewEODClientWithRateLimit("test-key", 1000)
+			client.httpClient.Transport = transport
+			client.MaxRetries = 3
+			client.RetryBackoff = time.Millisecond
+
+			_, err := client.GetPrices([]string{"SPY"}, "2024-01-01", "2024-01-31")
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetPrices() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if transport.calls != tt.wantCalls {
+				t.Errorf("made %d requests, want %d", transport.calls, tt.wantCalls)
+			}
+		})
+	}
+}
+
+func TestRetryBackoff(t *testing.T) {
+	if got := retryBackoff(time.Second, 0); got != time.Second {
+		t.Errorf("retryBackoff(1s, 0) = %v, want 1s", got)
+	}
+	if got := retryBackoff(time.Second, 3); got != 8*time.Second {
+		t.Errorf("retryBackoff(1s, 3) = %v, want 8s", got)
+	}
+	if got := retryBackoff(time.Second, 10); got != maxRetryBackoff {
+		t.Errorf("retryBackoff(1s, 10) = %v, want %v", got, maxRetryBackoff)
+	}
+}
+
+func TestGetPricesWithProgress(t *testing.T) {
+	client := NewEODClientWithRateLimit("test-key", 1000)
+	client.httpClient.Transport = &sequenceTransport{statuses: []int{200}}
+
+	symbols := []string{"A", "B", "C", "D"}
+	progress := make(chan int, len(symbols))
+	if _, err := client.GetPricesWithProgress(symbols, "2024-01-01", "2024-01-31", progress); err != nil {
+		t.Fatalf("GetPricesWithProgress() error = %v", err)
+	}
+	close(progress)
+
+	var counts []int
+	for n := range progress {
+		counts = append(counts, n)
+	}
+	if len(counts) != len(symbols) {
+		t.Fatalf("got %d progress updates, want %d", len(counts), len(symbols))
+	}
+	for i, n := range counts {
+		if n != i+1 {
+			t.Errorf("progress update %d = %d, want %d", i, n, i+1)
+		}
+	}
+
+	// An unbuffered channel nobody reads from must not block the fetch
+	if _, err := client.GetPricesWithProgress(symbols, "2024-01-01", "2024-01-31", make(chan int)); err != nil {
+		t.Fatalf("GetPricesWithProgress() error = %v", err)
+	}
+}
@@ -0,0 +1,87 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"text/tabwriter"
+)
+
+const (
+	outputTable = "table"
+	outputJSON  = "json"
+	outputCSV   = "csv"
+)
+
+func validateOutputFormat(format string) error {
+	switch format {
+	case outputTable, outputJSON, outputCSV:
+		return nil
+	default:
+		return fmt.Errorf("unknown output format %q: must be table, json or csv", format)
+	}
+}
+
+// writePrices renders price data per symbol in the given output format.
+// Symbols are written in sorted order so output is stable between runs.
+func writePrices(w io.Writer, format string, results map[string][]StockPrice) error {
+	symbols := make([]string, 0, len(results))
+	for symbol := range results {
+		symbols = append(symbols, symbol)
+	}
+	sort.Strings(symbols)
+
+	switch format {
+	case outputTable:
+		return writePriceTable(w, symbols, results)
+	case outputJSON:
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(results)
+	case outputCSV:
+		return writePriceCSV(w, symbols, results)
+	default:
+		return validateOutputFormat(format)
+	}
+}
+
+func writePriceTable(w io.Writer, symbols []string, results map[string][]StockPrice) error {
+	for _, symbol := range symbols {
+		fmt.Fprintf(w, "\nPrice data for %s:\n", symbol)
+		tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', tabwriter.AlignRight)
+		fmt.Fprintln(tw, "Date\tOpen\tHigh\tLow\tClose\tAdjustedClose\t")
+		for _, price := range results[symbol] {
+			fmt.Fprintf(tw, "%s\t$%.2f\t$%.2f\t$%.2f\t$%.2f\t$%.2f\t\n",
+				price.Date, price.Open, price.High, price.Low,
+				price.Close, price.AdjustedClose)
+		}
+		if err := tw.Flush(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func writePriceCSV(w io.Writer, symbols []string, results map[string][]StockPrice) error {
+	cw := csv.NewWriter(w)
+	cw.Write([]string{"symbol", "date", "open", "high", "low", "close", "adjusted_close", "volume"})
+
+	format := func(v float64) string {
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	}
+	for _, symbol := range symbols {
+		for _, price := range results[symbol] {
+			cw.Write([]string{
+				symbol, price.Date,
+				format(price.Open), format(price.High), format(price.Low),
+				format(price.Close), format(price.AdjustedClose), format(price.Volume),
+			})
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
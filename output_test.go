@@ -0,0 +1,60 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestWritePrices(t *testing.T) {
+	results := map[string][]StockPrice{
+		"SPY":  {{Date: "2024-01-02", Open: 472.16, High: 473.67, Low: 470.49, Close: 472.65, AdjustedClose: 466.61, Volume: 123623700}},
+		"AAPL": {{Date: "2024-01-02", Open: 187.15, High: 188.44, Low: 183.89, Close: 185.64, AdjustedClose: 184.94, Volume: 82488700}},
+	}
+
+	t.Run("table", func(t *testing.T) {
+		var buf bytes.Buffer
+		if err := writePrices(&buf, outputTable, results); err != nil {
+			t.Fatalf("writePrices() error = %v", err)
+		}
+		out := buf.String()
+		if strings.Index(out, "AAPL") > strings.Index(out, "SPY") {
+			t.Errorf("table output not sorted by symbol:\n%s", out)
+		}
+		if !strings.Contains(out, "$472.65") {
+			t.Errorf("table output missing SPY close:\n%s", out)
+		}
+	})
+
+	t.Run("json", func(t *testing.T) {
+		var buf bytes.Buffer
+		if err := writePrices(&buf, outputJSON, results); err != nil {
+			t.Fatalf("writePrices() error = %v", err)
+		}
+		var decoded map[string][]StockPrice
+		if err := json.Unmarshal(buf.Bytes(), &decoded); err != nil {
+			t.Fatalf("json output does not parse: %v", err)
+		}
+		if decoded["SPY"][0] != results["SPY"][0] {
+			t.Errorf("decoded SPY = %+v, want %+v", decoded["SPY"][0], results["SPY"][0])
+		}
+	})
+
+	t.Run("csv", func(t *testing.T) {
+		var buf bytes.Buffer
+		if err := writePrices(&buf, outputCSV, results); err != nil {
+			t.Fatalf("writePrices() error = %v", err)
+		}
+		want := "symbol,date,open,high,low,close,adjusted_close,volume\n" +
+			"AAPL,2024-01-02,187.15,188.44,183.89,185.64,184.94,82488700\n" +
+			"SPY,2024-01-02,472.16,473.67,470.49,472.65,466.61,123623700\n"
+		if buf.String() != want {
+			t.Errorf("csv output = %q, want %q", buf.String(), want)
+		}
+	})
+
+	if err := writePrices(&bytes.Buffer{}, "xml", results); err == nil {
+		t.Error("writePrices() with unknown format should fail")
+	}
+}
@@ -0,0 +1,109 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+type polygonAggregate struct {
+	Open      float64 `json:"o"`
+	High      float64 `json:"h"`
+	Low       float64 `json:"l"`
+	Close     float64 `json:"c"`
+	VWAP      float64 `json:"vw"`
+	Volume    float64 `json:"v"`
+	Timestamp int64   `json:"t"`
+}
+
+type polygonAggregatesResponse struct {
+	Status  string             `json:"status"`
+	Error   string             `json:"error"`
+	Results []polygonAggregate `json:"results"`
+}
+
+type PolygonClient struct {
+	apiKey     string
+	baseURL    string
+	httpClient *http.Client
+}
+
+func NewPolygonClient(apiKey string) *PolygonClient {
+	return &PolygonClient{
+		apiKey:  apiKey,
+		baseURL: "https://api.polygon.io",
+		httpClient: &http.Client{
+			Timeout: 10 * time.Second,
+		},
+	}
+}
+
+// GetPrices fetches daily split-adjusted bars for each symbol.
+func (c *PolygonClient) GetPrices(symbols []string, startDate, endDate string) (map[string][]StockPrice, error) {
+	if len(symbols) == 0 {
+		return nil, fmt.Errorf("no symbols provided")
+	}
+	if err := validateDate(startDate); err != nil {
+		return nil, fmt.Errorf("invalid startDate: %v", err)
+	}
+	if err := validateDate(endDate); err != nil {
+		return nil, fmt.Errorf("invalid endDate: %v", err)
+	}
+
+	return fetchConcurrently(symbols, nil, func(symbol string) ([]StockPrice, error) {
+		return c.GetAggregates(symbol, "1", "day", startDate, endDate)
+	})
+}
+
+// GetAggregates fetches adjusted bars of multiplier*timespan (e.g. 1 day)
+// between from and to inclusive. Polygon's adjusted close is used for both
+// Close and AdjustedClose; the VWAP has no StockPrice field and is dropped.
+func (c *PolygonClient) GetAggregates(ticker, multiplier, timespan, from, to string) ([]StockPrice, error) {
+	if c.apiKey == "" {
+		return nil, fmt.Errorf("API key is missing")
+	}
+
+	url := fmt.Sprintf("%s/v2/aggs/ticker/%s/range/%s/%s/%s/%s?adjusted=true&sort=asc&limit=50000&apiKey=%s",
+		c.baseURL, ticker, multiplier, timespan, from, to, c.apiKey)
+
+	resp, err := c.httpClient.Get(url)
+	if err != nil {
+		return nil, fmt.Errorf("error making request: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(body))
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading response: %v", err)
+	}
+
+	var aggs polygonAggregatesResponse
+	if err := json.Unmarshal(body, &aggs); err != nil {
+		return nil, fmt.Errorf("error parsing JSON: %v", err)
+	}
+	if aggs.Status == "ERROR" {
+		return nil, fmt.Errorf("API returned error: %s", aggs.Error)
+	}
+
+	prices := make([]StockPrice, len(aggs.Results))
+	for i, agg := range aggs.Results {
+		prices[i] = StockPrice{
+			Date:          time.UnixMilli(agg.Timestamp).UTC().Format("2006-01-02"),
+			Open:          agg.Open,
+			High:          agg.High,
+			Low:           agg.Low,
+			Close:         agg.Close,
+			AdjustedClose: agg.Close,
+			Volume:        agg.Volume,
+		}
+	}
+
+	return prices, nil
+}
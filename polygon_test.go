@@ -0,0 +1,63 @@
+package main
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestPolygonGetPrices(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("apiKey") != "test-key" {
+			http.Error(w, `{"status":"ERROR","error":"Unknown API Key"}`, http.StatusUnauthorized)
+			return
+		}
+		switch r.URL.Path {
+		case "/v2/aggs/ticker/SPY/range/1/day/2024-01-01/2024-01-05":
+			w.Write([]byte(`{"ticker":"SPY","status":"OK","resultsCount":2,"results":[
+				{"v":123623700,"vw":472.1,"o":472.16,"c":472.65,"h":473.67,"l":470.49,"t":1704171600000,"n":800000},
+				{"v":103585900,"vw":469.5,"o":470.43,"c":468.79,"h":471.19,"l":468.17,"t":1704258000000,"n":700000}
+			]}`))
+		default:
+			w.Write([]byte(`{"status":"ERROR","error":"ticker not found"}`))
+		}
+	}))
+	defer server.Close()
+
+	var provider MarketDataProvider = NewPolygonClient("test-key")
+	provider.(*PolygonClient).baseURL = server.URL
+
+	results, err := provider.GetPrices([]string{"SPY", "NOPE"}, "2024-01-01", "2024-01-05")
+
+	var multiErr *MultiError
+	if !errors.As(err, &multiErr) || len(multiErr.Errors) != 1 {
+		t.Fatalf("GetPrices() error = %v, want *MultiError with one failure", err)
+	}
+
+	prices := results["SPY"]
+	if len(prices) != 2 {
+		t.Fatalf("got %d SPY prices, want 2", len(prices))
+	}
+	want := StockPrice{Date: "2024-01-02", Open: 472.16, High: 473.67, Low: 470.49, Close: 472.65, AdjustedClose: 472.65, Volume: 123623700}
+	if prices[0] != want {
+		t.Errorf("prices[0] = %+v, want %+v", prices[0], want)
+	}
+	if prices[1].Date != "2024-01-03" {
+		t.Errorf("prices[1].Date = %s, want 2024-01-03", prices[1].Date)
+	}
+}
+
+func TestPolygonGetAggregatesBadKey(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"status":"ERROR","error":"Unknown API Key"}`, http.StatusUnauthorized)
+	}))
+	defer server.Close()
+
+	client := NewPolygonClient("bad-key")
+	client.baseURL = server.URL
+
+	if _, err := client.GetAggregates("SPY", "1", "day", "2024-01-01", "2024-01-05"); err == nil {
+		t.Error("GetAggregates() with bad key should fail")
+	}
+}
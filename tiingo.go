@@ -0,0 +1,117 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+type tiingoPrice struct {
+	Date     string  `json:"date"`
+	Open     float64 `json:"open"`
+	High     float64 `json:"high"`
+	Low      float64 `json:"low"`
+	Close    float64 `json:"close"`
+	AdjClose float64 `json:"adjClose"`
+	Volume   float64 `json:"volume"`
+}
+
+type TiingoClient struct {
+	apiKey     string
+	baseURL    string
+	httpClient *http.Client
+}
+
+func NewTiingoClient(apiKey string) *TiingoClient {
+	return &TiingoClient{
+		apiKey:  apiKey,
+		baseURL: "https://api.tiingo.com",
+		httpClient: &http.Client{
+			Timeout: 10 * time.Second,
+		},
+	}
+}
+
+func (c *TiingoClient) GetPrices(symbols []string, startDate, endDate string) (map[string][]StockPrice, error) {
+	if len(symbols) == 0 {
+		return nil, fmt.Errorf("no symbols provided")
+	}
+	if err := validateDate(startDate); err != nil {
+		return nil, fmt.Errorf("invalid startDate: %v", err)
+	}
+	if err := validateDate(endDate); err != nil {
+		return nil, fmt.Errorf("invalid endDate: %v", err)
+	}
+
+	return fetchConcurrently(symbols, nil, func(symbol string) ([]StockPrice, error) {
+		return c.GetDailyPrices(symbol, startDate, endDate)
+	})
+}
+
+func (c *TiingoClient) GetDailyPrices(ticker, startDate, endDate string) ([]StockPrice, error) {
+	if c.apiKey == "" {
+		return nil, fmt.Errorf("API key is missing")
+	}
+
+	params := url.Values{}
+	params.Set("startDate", startDate)
+	params.Set("endDate", endDate)
+	params.Set("format", "json")
+	endpoint := fmt.Sprintf("%s/tiingo/daily/%s/prices?%s", c.baseURL, ticker, params.Encode())
+
+	req, err := http.NewRequest(http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %v", err)
+	}
+	req.Header.Set("Authorization", "Token "+c.apiKey)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error making request: %v", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading response: %v", err)
+	}
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusUnauthorized:
+		return nil, fmt.Errorf("invalid API key: %s", string(body))
+	case http.StatusNotFound:
+		return nil, fmt.Errorf("unknown ticker %s: %s", ticker, string(body))
+	default:
+		return nil, fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(body))
+	}
+
+	var raw []tiingoPrice
+	if err := json.Unmarshal(body, &raw); err != nil {
+		return nil, fmt.Errorf("error parsing JSON: %v", err)
+	}
+
+	prices := make([]StockPrice, len(raw))
+	for i, p := range raw {
+		// Tiingo dates are timestamps like 2024-01-02T00:00:00.000Z
+		date := p.Date
+		if len(date) > len("2006-01-02") {
+			date = date[:len("2006-01-02")]
+		}
+		prices[i] = StockPrice{
+			Date:          date,
+			Open:          p.Open,
+			High:          p.High,
+			Low:           p.Low,
+			Close:         p.Close,
+			AdjustedClose: p.AdjClose,
+			Volume:        p.Volume,
+		}
+	}
+
+	return prices, nil
+}
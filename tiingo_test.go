@@ -0,0 +1,58 @@
+package main
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func newTestTiingoServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Token test-key" {
+			w.WriteHeader(http.StatusUnauthorized)
+			w.Write([]byte(`{"detail":"Invalid token."}`))
+			return
+		}
+		if r.URL.Path != "/tiingo/daily/SPY/prices" {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"detail":"Error: Ticker 'NOPE' not found"}`))
+			return
+		}
+		w.Write([]byte(`[{"date":"2024-01-02T00:00:00.000Z","close":472.65,"high":473.67,"low":470.49,"open":472.16,"volume":123623700,"adjClose":466.61}]`))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestTiingoGetPrices(t *testing.T) {
+	client := NewTiingoClient("test-key")
+	client.baseURL = newTestTiingoServer(t).URL
+
+	results, err := client.GetPrices([]string{"SPY", "NOPE"}, "2024-01-01", "2024-01-05")
+
+	var multiErr *MultiError
+	if !errors.As(err, &multiErr) || len(multiErr.Errors) != 1 {
+		t.Fatalf("GetPrices() error = %v, want *MultiError with one failure", err)
+	}
+	if !strings.Contains(multiErr.Errors[0].Error(), "unknown ticker NOPE") {
+		t.Errorf("error = %v, want unknown ticker", multiErr.Errors[0])
+	}
+
+	want := StockPrice{Date: "2024-01-02", Open: 472.16, High: 473.67, Low: 470.49, Close: 472.65, AdjustedClose: 466.61, Volume: 123623700}
+	if prices := results["SPY"]; len(prices) != 1 || prices[0] != want {
+		t.Errorf("results[SPY] = %+v, want [%+v]", prices, want)
+	}
+}
+
+func TestTiingoBadKey(t *testing.T) {
+	client := NewTiingoClient("bad-key")
+	client.baseURL = newTestTiingoServer(t).URL
+
+	_, err := client.GetDailyPrices("SPY", "2024-01-01", "2024-01-05")
+	if err == nil || !strings.Contains(err.Error(), "invalid API key") {
+		t.Errorf("GetDailyPrices() error = %v, want invalid API key", err)
+	}
+}
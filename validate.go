@@ -0,0 +1,67 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"time"
+)
+
+// ValidatePrices checks a price series for data errors: non-positive OHLC
+// values, Open or Close outside the Low-High range, future dates, and dates
+// that are not strictly increasing. All problems found are returned joined.
+func ValidatePrices(prices []StockPrice) error {
+	var errs []error
+	var prev *StockPrice
+	for i := range prices {
+		if err := validatePrice(prices[i], prev); err != nil {
+			errs = append(errs, fmt.Errorf("row %d (%s): %v", i, prices[i].Date, err))
+			continue
+		}
+		prev = &prices[i]
+	}
+	return errors.Join(errs...)
+}
+
+// validatePrice checks a single row against the previous valid row, if any.
+func validatePrice(p StockPrice, prev *StockPrice) error {
+	if p.Open <= 0 || p.High <= 0 || p.Low <= 0 || p.Close <= 0 {
+		return fmt.Errorf("OHLC values must be positive")
+	}
+	if p.Open < p.Low || p.Open > p.High {
+		return fmt.Errorf("open %.2f outside low-high range %.2f-%.2f", p.Open, p.Low, p.High)
+	}
+	if p.Close < p.Low || p.Close > p.High {
+		return fmt.Errorf("close %.2f outside low-high range %.2f-%.2f", p.Close, p.Low, p.High)
+	}
+
+	date, err := time.Parse("2006-01-02", p.Date)
+	if err != nil {
+		return fmt.Errorf("date must be YYYY-MM-DD format")
+	}
+	if date.After(time.Now()) {
+		return fmt.Errorf("date is in the future")
+	}
+	// YYYY-MM-DD strings sort chronologically
+	if prev != nil && p.Date <= prev.Date {
+		return fmt.Errorf("date is not after previous date %s", prev.Date)
+	}
+
+	return nil
+}
+
+// dropInvalidPrices returns prices without the rows ValidatePrices would
+// reject, logging a warning for each dropped row.
+func dropInvalidPrices(symbol string, prices []StockPrice) []StockPrice {
+	valid := make([]StockPrice, 0, len(prices))
+	var prev *StockPrice
+	for _, p := range prices {
+		if err := validatePrice(p, prev); err != nil {
+			log.Printf("warning: skipping %s price on %s: %v", symbol, p.Date, err)
+			continue
+		}
+		valid = append(valid, p)
+		prev = &valid[len(valid)-1]
+	}
+	return valid
+}
@@ -0,0 +1,67 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestValidatePrices(t *testing.T) {
+	good := StockPrice{Date: "2024-01-02", Open: 10, High: 12, Low: 9, Close: 11}
+	next := StockPrice{Date: "2024-01-03", Open: 11, High: 13, Low: 10, Close: 12}
+
+	tests := []struct {
+		name    string
+		prices  []StockPrice
+		wantErr bool
+	}{
+		{"Valid series", []StockPrice{good, next}, false},
+		{"Empty series", nil, false},
+		{"Zero open", []StockPrice{{Date: "2024-01-02", Open: 0, High: 12, Low: 9, Close: 11}}, true},
+		{"Negative low", []StockPrice{{Date: "2024-01-02", Open: 10, High: 12, Low: -1, Close: 11}}, true},
+		{"Open above high", []StockPrice{{Date: "2024-01-02", Open: 13, High: 12, Low: 9, Close: 11}}, true},
+		{"Open below low", []StockPrice{{Date: "2024-01-02", Open: 8, High: 12, Low: 9, Close: 11}}, true},
+		{"Close above high", []StockPrice{{Date: "2024-01-02", Open: 10, High: 12, Low: 9, Close: 13}}, true},
+		{"Close below low", []StockPrice{{Date: "2024-01-02", Open: 10, High: 12, Low: 9, Close: 8}}, true},
+		{"Future date", []StockPrice{{Date: "2999-01-02", Open: 10, High: 12, Low: 9, Close: 11}}, true},
+		{"Malformed date", []StockPrice{{Date: "01-02-2024", Open: 10, High: 12, Low: 9, Close: 11}}, true},
+		{"Duplicate date", []StockPrice{good, good}, true},
+		{"Decreasing date", []StockPrice{next, good}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidatePrices(tt.prices)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidatePrices() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestGetPricesValidationModes(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`[
+			{"date":"2024-01-02","open":10,"high":12,"low":9,"close":11},
+			{"date":"2024-01-03","open":0,"high":12,"low":9,"close":11},
+			{"date":"2024-01-04","open":11,"high":13,"low":10,"close":12}
+		]`))
+	}))
+	defer server.Close()
+
+	client := NewEODClient("test-key")
+	client.baseURL = server.URL
+
+	results, err := client.GetPrices([]string{"SPY"}, "2024-01-01", "2024-01-31")
+	if err != nil {
+		t.Fatalf("lenient GetPrices() error = %v", err)
+	}
+	if len(results["SPY"]) != 2 {
+		t.Errorf("lenient GetPrices() kept %d rows, want 2", len(results["SPY"]))
+	}
+
+	client.Strict = true
+	if _, err := client.GetPrices([]string{"SPY"}, "2024-01-01", "2024-01-31"); err == nil {
+		t.Error("strict GetPrices() should fail on the zero open row")
+	}
+}
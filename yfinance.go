@@ -0,0 +1,162 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// chartResponse mirrors the Yahoo Finance v8 chart payload. Yahoo reports
+// missing bars as nulls, and nests adjclose separately from the OHLCV quote.
+type chartResponse struct {
+	Chart struct {
+		Result []struct {
+			Timestamp  []int64 `json:"timestamp"`
+			Indicators struct {
+				Quote []struct {
+					Open   []*float64 `json:"open"`
+					High   []*float64 `json:"high"`
+					Low    []*float64 `json:"low"`
+					Close  []*float64 `json:"close"`
+					Volume []*float64 `json:"volume"`
+				} `json:"quote"`
+				AdjClose []struct {
+					AdjClose []*float64 `json:"adjclose"`
+				} `json:"adjclose"`
+			} `json:"indicators"`
+		} `json:"result"`
+		Error *struct {
+			Code        string `json:"code"`
+			Description string `json:"description"`
+		} `json:"error"`
+	} `json:"chart"`
+}
+
+// YahooClient fetches daily prices from the public Yahoo Finance chart API,
+// which needs no API key.
+type YahooClient struct {
+	baseURL    string
+	httpClient *http.Client
+}
+
+func NewYahooClient() *YahooClient {
+	return &YahooClient{
+		baseURL: "https://query2.finance.yahoo.com",
+		httpClient: &http.Client{
+			Timeout: 10 * time.Second,
+		},
+	}
+}
+
+func (c *YahooClient) GetPrices(symbols []string, startDate, endDate string) (map[string][]StockPrice, error) {
+	if len(symbols) == 0 {
+		return nil, fmt.Errorf("no symbols provided")
+	}
+
+	start, err := time.Parse("2006-01-02", startDate)
+	if err != nil {
+		return nil, fmt.Errorf("invalid startDate: must be YYYY-MM-DD format")
+	}
+	end, err := time.Parse("2006-01-02", endDate)
+	if err != nil {
+		return nil, fmt.Errorf("invalid endDate: must be YYYY-MM-DD format")
+	}
+
+	return fetchConcurrently(symbols, nil, func(symbol string) ([]StockPrice, error) {
+		return c.fetchChart(symbol, start, end)
+	})
+}
+
+func (c *YahooClient) fetchChart(symbol string, start, end time.Time) ([]StockPrice, error) {
+	// period2 is exclusive, so extend by a day to include endDate
+	url := fmt.Sprintf("%s/v8/finance/chart/%s?period1=%d&period2=%d&interval=1d&events=div,split",
+		c.baseURL, symbol, start.Unix(), end.AddDate(0, 0, 1).Unix())
+
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %v", err)
+	}
+	// Yahoo rejects requests without a browser-like user agent
+	req.Header.Set("User-Agent", "Mozilla/5.0")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error making request: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(body))
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading response: %v", err)
+	}
+
+	var chart chartResponse
+	if err := json.Unmarshal(body, &chart); err != nil {
+		return nil, fmt.Errorf("error parsing JSON: %v", err)
+	}
+
+	return chart.toStockPrices()
+}
+
+func (r chartResponse) toStockPrices() ([]StockPrice, error) {
+	if r.Chart.Error != nil {
+		return nil, fmt.Errorf("%s: %s", r.Chart.Error.Code, r.Chart.Error.Description)
+	}
+	if len(r.Chart.Result) == 0 {
+		return nil, fmt.Errorf("no chart data returned")
+	}
+
+	result := r.Chart.Result[0]
+	if len(result.Indicators.Quote) == 0 {
+		return nil, fmt.Errorf("no quote data returned")
+	}
+	quote := result.Indicators.Quote[0]
+
+	var adjClose []*float64
+	if len(result.Indicators.AdjClose) > 0 {
+		adjClose = result.Indicators.AdjClose[0].AdjClose
+	}
+
+	value := func(series []*float64, i int) (float64, bool) {
+		if i >= len(series) || series[i] == nil {
+			return 0, false
+		}
+		return *series[i], true
+	}
+
+	prices := make([]StockPrice, 0, len(result.Timestamp))
+	for i, ts := range result.Timestamp {
+		closePrice, ok := value(quote.Close, i)
+		if !ok {
+			// Skip bars Yahoo has no close for (e.g. halted days)
+			continue
+		}
+		open, _ := value(quote.Open, i)
+		high, _ := value(quote.High, i)
+		low, _ := value(quote.Low, i)
+		volume, _ := value(quote.Volume, i)
+		adjusted, ok := value(adjClose, i)
+		if !ok {
+			adjusted = closePrice
+		}
+
+		prices = append(prices, StockPrice{
+			Date:          time.Unix(ts, 0).UTC().Format("2006-01-02"),
+			Open:          open,
+			High:          high,
+			Low:           low,
+			Close:         closePrice,
+			AdjustedClose: adjusted,
+			Volume:        volume,
+		})
+	}
+
+	return prices, nil
+}
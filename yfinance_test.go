@@ -0,0 +1,61 @@
+package main
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestYahooGetPrices(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v8/finance/chart/SPY":
+			// 2024-01-02 and 2024-01-03 at 14:30 UTC, plus a bar with a null close
+			w.Write([]byte(`{"chart":{"result":[{
+				"timestamp":[1704205800,1704292200,1704378600],
+				"indicators":{
+					"quote":[{"open":[472.16,470.43,null],"high":[473.67,471.19,null],"low":[470.49,468.17,null],"close":[472.65,468.79,null],"volume":[123623700,103585900,null]}],
+					"adjclose":[{"adjclose":[466.61,462.80,null]}]
+				}
+			}],"error":null}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"chart":{"result":null,"error":{"code":"Not Found","description":"No data found, symbol may be delisted"}}}`))
+		}
+	}))
+	defer server.Close()
+
+	client := NewYahooClient()
+	client.baseURL = server.URL
+
+	results, err := client.GetPrices([]string{"SPY", "NOPE"}, "2024-01-01", "2024-01-05")
+
+	var multiErr *MultiError
+	if !errors.As(err, &multiErr) || len(multiErr.Errors) != 1 {
+		t.Fatalf("GetPrices() error = %v, want *MultiError with one failure", err)
+	}
+
+	prices := results["SPY"]
+	if len(prices) != 2 {
+		t.Fatalf("got %d SPY prices, want 2 (null bar skipped)", len(prices))
+	}
+	want := StockPrice{Date: "2024-01-02", Open: 472.16, High: 473.67, Low: 470.49, Close: 472.65, AdjustedClose: 466.61, Volume: 123623700}
+	if prices[0] != want {
+		t.Errorf("prices[0] = %+v, want %+v", prices[0], want)
+	}
+	if prices[1].Date != "2024-01-03" || prices[1].AdjustedClose != 462.80 {
+		t.Errorf("prices[1] = %+v", prices[1])
+	}
+}
+
+func TestYahooGetPricesInvalidInput(t *testing.T) {
+	client := NewYahooClient()
+
+	if _, err := client.GetPrices(nil, "2024-01-01", "2024-01-05"); err == nil {
+		t.Error("GetPrices() with no symbols should fail")
+	}
+	if _, err := client.GetPrices([]string{"SPY"}, "01-01-2024", "2024-01-05"); err == nil {
+		t.Error("GetPrices() with invalid startDate should fail")
+	}
+}